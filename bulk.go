@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bulkWorkers bounds how many receipts in a single bulk request are validated
+// and saved concurrently.
+const bulkWorkers = 8
+
+// BulkReceiptRequest is the payload for POST /receipts/process/bulk.
+type BulkReceiptRequest struct {
+	Receipts []Receipt `json:"receipts"`
+}
+
+// BulkReceiptResult is one entry in the bulk response: the ID of a successfully
+// saved receipt, or the error that rejected it. Index lets a caller match a
+// result back to the request that produced it.
+type BulkReceiptResult struct {
+	Index int    `json:"index"`
+	ID    string `json:"id,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Accepts a batch of JSON receipts, validates and stores each independently so one bad
+// entry doesn't abort the rest, and returns a per-receipt result in the same order as the input.
+func (a *api) processReceiptsBulk(c *gin.Context) {
+	var req BulkReceiptRequest
+	if err := c.BindJSON(&req); err != nil {
+		c.AbortWithStatusJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	results := make([]BulkReceiptResult, len(req.Receipts))
+
+	ctx := c.Request.Context()
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, bulkWorkers)
+	for i, receipt := range req.Receipts {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, receipt Receipt) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			results[i] = a.processOneBulkReceipt(ctx, i, receipt)
+		}(i, receipt)
+	}
+	wg.Wait()
+
+	c.IndentedJSON(200, gin.H{"results": results})
+}
+
+// processOneBulkReceipt validates and saves a single receipt from a bulk request.
+func (a *api) processOneBulkReceipt(ctx context.Context, index int, receipt Receipt) BulkReceiptResult {
+	if err := ValidateReceipt(receipt); err != nil {
+		return BulkReceiptResult{Index: index, Error: validationMessage(err)}
+	}
+
+	id, err := a.saveReceipt(ctx, receipt)
+	if err != nil {
+		return BulkReceiptResult{Index: index, Error: "Failed to save receipt"}
+	}
+	return BulkReceiptResult{Index: index, ID: id}
+}
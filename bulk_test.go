@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/cache"
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/events"
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/rules"
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/store"
+)
+
+func newTestAPI(t *testing.T) *api {
+	t.Helper()
+	ruleEngine, err := rules.NewEngine(rules.DefaultConfig())
+	if err != nil {
+		t.Fatalf("rules.NewEngine() error = %v", err)
+	}
+	return &api{store: store.NewMemoryStore(), rules: ruleEngine, events: events.NewNoopBus(), cache: cache.NewLRUCache(100)}
+}
+
+func TestProcessReceiptsBulkPreservesOrderAndPartialFailure(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	a := newTestAPI(t)
+
+	body := BulkReceiptRequest{
+		Receipts: []Receipt{
+			{Retailer: "Target", PurchaseDate: "2022-01-01", PurchaseTime: "13:01", Total: "6.49", Items: []Item{{ShortDescription: "Pepsi", Price: "6.49"}}},
+			{Retailer: "Bad!!", PurchaseDate: "2022-01-01", PurchaseTime: "13:01", Total: "6.49"},
+			{Retailer: "Walmart", PurchaseDate: "2022-01-02", PurchaseTime: "14:30", Total: "10.00", Items: []Item{{ShortDescription: "Soap", Price: "10.00"}}},
+		},
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/receipts/process/bulk", bytes.NewReader(payload))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	a.processReceiptsBulk(c)
+
+	if recorder.Code != 200 {
+		t.Fatalf("status = %d, want 200", recorder.Code)
+	}
+
+	var resp struct {
+		Results []BulkReceiptResult `json:"results"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if len(resp.Results) != 3 {
+		t.Fatalf("len(Results) = %d, want 3", len(resp.Results))
+	}
+	for i, result := range resp.Results {
+		if result.Index != i {
+			t.Errorf("Results[%d].Index = %d, want %d", i, result.Index, i)
+		}
+	}
+	if resp.Results[0].ID == "" || resp.Results[0].Error != "" {
+		t.Errorf("Results[0] = %+v, want a saved receipt with no error", resp.Results[0])
+	}
+	if resp.Results[1].Error == "" {
+		t.Errorf("Results[1] = %+v, want a validation error", resp.Results[1])
+	}
+	if resp.Results[2].ID == "" || resp.Results[2].Error != "" {
+		t.Errorf("Results[2] = %+v, want a saved receipt with no error", resp.Results[2])
+	}
+}
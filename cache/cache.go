@@ -0,0 +1,29 @@
+// Package cache stores computed receipt points so GET /receipts/:id/points
+// doesn't re-run the scoring rules on every request.
+package cache
+
+// Points is the cached result of scoring a receipt: its total points and the
+// per-rule breakdown, mirroring what GET /receipts/:id/points returns.
+type Points struct {
+	Total     int            `json:"total"`
+	Breakdown map[string]int `json:"breakdown"`
+}
+
+// Cache stores computed Points keyed by a receipt ID and the rule-set
+// version that produced them. Keying on version means a rule-config reload
+// (which bumps the version) naturally invalidates every entry computed
+// under the old rules — old keys simply stop being looked up — without the
+// cache needing an explicit invalidate-all operation.
+type Cache interface {
+	// Get returns the cached Points for (receiptID, ruleSetVersion), or
+	// ok=false on a miss.
+	Get(receiptID string, ruleSetVersion uint64) (points Points, ok bool)
+
+	// Set stores points for (receiptID, ruleSetVersion).
+	Set(receiptID string, ruleSetVersion uint64, points Points)
+}
+
+// key builds the cache key for a receipt's points under a given rule-set version.
+func key(receiptID string, ruleSetVersion uint64) string {
+	return receiptID + "@" + formatVersion(ruleSetVersion)
+}
@@ -0,0 +1,26 @@
+package cache
+
+import (
+	"os"
+	"strconv"
+)
+
+// defaultLRUCapacity bounds the in-memory cache when POINTS_CACHE_SIZE isn't set.
+const defaultLRUCapacity = 10000
+
+// NewCacheFromEnv returns a RedisCache pointed at REDIS_ADDR when
+// POINTS_CACHE=redis, or an in-memory LRUCache otherwise (the default),
+// sized by POINTS_CACHE_SIZE.
+func NewCacheFromEnv() Cache {
+	if os.Getenv("POINTS_CACHE") == "redis" {
+		return NewRedisCache(os.Getenv("REDIS_ADDR"))
+	}
+
+	capacity := defaultLRUCapacity
+	if raw := os.Getenv("POINTS_CACHE_SIZE"); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil && parsed > 0 {
+			capacity = parsed
+		}
+	}
+	return NewLRUCache(capacity)
+}
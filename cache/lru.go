@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"container/list"
+	"strconv"
+	"sync"
+)
+
+// formatVersion renders ruleSetVersion for use in a cache key.
+func formatVersion(ruleSetVersion uint64) string {
+	return strconv.FormatUint(ruleSetVersion, 10)
+}
+
+// lruEntry is the value stored in LRUCache's linked list.
+type lruEntry struct {
+	key    string
+	points Points
+}
+
+// LRUCache is an in-memory Cache bounded to capacity entries, evicting the
+// least recently used entry once full.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+// NewLRUCache returns an LRUCache that holds at most capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get implements Cache.
+func (c *LRUCache) Get(receiptID string, ruleSetVersion uint64) (Points, bool) {
+	k := key(receiptID, ruleSetVersion)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[k]
+	if !ok {
+		return Points{}, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry).points, true
+}
+
+// Set implements Cache.
+func (c *LRUCache) Set(receiptID string, ruleSetVersion uint64, points Points) {
+	k := key(receiptID, ruleSetVersion)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[k]; ok {
+		elem.Value.(*lruEntry).points = points
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[k] = c.order.PushFront(&lruEntry{key: k, points: points})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
@@ -0,0 +1,56 @@
+package cache
+
+import "testing"
+
+func TestLRUCacheGetMiss(t *testing.T) {
+	c := NewLRUCache(2)
+
+	if _, ok := c.Get("r1", 1); ok {
+		t.Fatalf("Get() on empty cache returned ok = true, want false")
+	}
+}
+
+func TestLRUCacheSetAndGet(t *testing.T) {
+	c := NewLRUCache(2)
+	points := Points{Total: 42, Breakdown: map[string]int{"round_dollar": 50}}
+
+	c.Set("r1", 1, points)
+
+	got, ok := c.Get("r1", 1)
+	if !ok {
+		t.Fatalf("Get() ok = false, want true")
+	}
+	if got.Total != points.Total {
+		t.Errorf("Get() Total = %d, want %d", got.Total, points.Total)
+	}
+}
+
+func TestLRUCacheVersionIsPartOfKey(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("r1", 1, Points{Total: 42})
+
+	if _, ok := c.Get("r1", 2); ok {
+		t.Fatalf("Get() under a different rule-set version returned ok = true, want false")
+	}
+}
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("r1", 1, Points{Total: 1})
+	c.Set("r2", 1, Points{Total: 2})
+
+	// Touch r1 so r2 becomes the least recently used entry.
+	c.Get("r1", 1)
+
+	c.Set("r3", 1, Points{Total: 3})
+
+	if _, ok := c.Get("r2", 1); ok {
+		t.Errorf("Get(r2) ok = true, want false (should have been evicted)")
+	}
+	if _, ok := c.Get("r1", 1); !ok {
+		t.Errorf("Get(r1) ok = false, want true (recently used, should survive)")
+	}
+	if _, ok := c.Get("r3", 1); !ok {
+		t.Errorf("Get(r3) ok = false, want true (just inserted)")
+	}
+}
@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCache is a Cache backed by Redis, so cached points survive restarts
+// and are shared across multiple API instances.
+type RedisCache struct {
+	client *redis.Client
+}
+
+// NewRedisCache connects to the Redis instance at addr (e.g. "localhost:6379").
+func NewRedisCache(addr string) *RedisCache {
+	return &RedisCache{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+// Get implements Cache.
+func (c *RedisCache) Get(receiptID string, ruleSetVersion uint64) (Points, bool) {
+	payload, err := c.client.Get(context.Background(), key(receiptID, ruleSetVersion)).Bytes()
+	if err != nil {
+		return Points{}, false
+	}
+
+	var points Points
+	if err := json.Unmarshal(payload, &points); err != nil {
+		return Points{}, false
+	}
+	return points, true
+}
+
+// Set implements Cache.
+func (c *RedisCache) Set(receiptID string, ruleSetVersion uint64, points Points) {
+	payload, err := json.Marshal(points)
+	if err != nil {
+		return
+	}
+	c.client.Set(context.Background(), key(receiptID, ruleSetVersion), payload, 0)
+}
@@ -0,0 +1,13 @@
+package events
+
+import "os"
+
+// NewBusFromEnv returns a RedisBus pointed at REDIS_ADDR if it's set, or a
+// NoopBus otherwise so the service still runs without Redis configured.
+func NewBusFromEnv() Bus {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		return NewNoopBus()
+	}
+	return NewRedisBus(addr)
+}
@@ -0,0 +1,30 @@
+// Package events publishes receipt lifecycle events (stored, scored) and lets
+// callers subscribe to the stream, so dashboards and other downstream
+// consumers can react in real time instead of polling the API.
+package events
+
+import "context"
+
+// Event types published by the bus. The value doubles as the Redis channel
+// name a RedisBus publishes to and subscribes from.
+const (
+	TypeReceiptProcessed = "receipt_processed"
+	TypePointsCalculated = "points_calculated"
+)
+
+// Event is the JSON payload published whenever a receipt is stored or scored.
+type Event struct {
+	Type      string      `json:"type"`
+	ReceiptID string      `json:"receiptId"`
+	Data      interface{} `json:"data,omitempty"`
+}
+
+// Bus publishes receipt lifecycle events and lets callers subscribe to the stream.
+type Bus interface {
+	// Publish sends event to every current subscriber.
+	Publish(ctx context.Context, event Event) error
+
+	// Subscribe returns a channel of events and an unsubscribe function the
+	// caller must invoke (e.g. via defer) once it stops reading from the channel.
+	Subscribe(ctx context.Context) (<-chan Event, func(), error)
+}
@@ -0,0 +1,23 @@
+package events
+
+import "context"
+
+// NoopBus discards every published event and never delivers anything to
+// subscribers. It's the default Bus so the service still runs without Redis.
+type NoopBus struct{}
+
+// NewNoopBus returns a Bus that does nothing.
+func NewNoopBus() *NoopBus {
+	return &NoopBus{}
+}
+
+// Publish discards event.
+func (*NoopBus) Publish(context.Context, Event) error {
+	return nil
+}
+
+// Subscribe returns a channel that never receives anything. The returned
+// unsubscribe function is a no-op.
+func (*NoopBus) Subscribe(context.Context) (<-chan Event, func(), error) {
+	return make(chan Event), func() {}, nil
+}
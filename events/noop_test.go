@@ -0,0 +1,27 @@
+package events
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNoopBusPublishAndSubscribe(t *testing.T) {
+	bus := NewNoopBus()
+	ctx := context.Background()
+
+	if err := bus.Publish(ctx, Event{Type: TypeReceiptProcessed, ReceiptID: "1"}); err != nil {
+		t.Fatalf("Publish() error = %v, want nil", err)
+	}
+
+	stream, unsubscribe, err := bus.Subscribe(ctx)
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v, want nil", err)
+	}
+	defer unsubscribe()
+
+	select {
+	case event := <-stream:
+		t.Fatalf("Subscribe() delivered %+v, want nothing", event)
+	default:
+	}
+}
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// channels lists every Redis pub/sub channel RedisBus publishes to and subscribes from.
+var channels = []string{TypeReceiptProcessed, TypePointsCalculated}
+
+// RedisBus is a Bus backed by Redis pub/sub, publishing to (and subscribing
+// from) a channel per event type.
+type RedisBus struct {
+	client *redis.Client
+}
+
+// NewRedisBus connects to the Redis instance at addr (e.g. "localhost:6379").
+func NewRedisBus(addr string) *RedisBus {
+	return &RedisBus{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+// Publish marshals event to JSON and publishes it to the Redis channel named by event.Type.
+func (b *RedisBus) Publish(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+	if err := b.client.Publish(ctx, event.Type, payload).Err(); err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+	return nil
+}
+
+// Subscribe subscribes to every event channel and relays decoded events onto
+// the returned channel until ctx is done or the caller calls the unsubscribe func.
+func (b *RedisBus) Subscribe(ctx context.Context) (<-chan Event, func(), error) {
+	sub := b.client.Subscribe(ctx, channels...)
+
+	out := make(chan Event)
+	go func() {
+		defer close(out)
+		for msg := range sub.Channel() {
+			var event Event
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				continue
+			}
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, func() { sub.Close() }, nil
+}
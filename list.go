@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/store"
+)
+
+// ReceiptPagedRequestCommand is the parsed, validated form of a GET /receipts
+// query string: paging, ordering, and the filters a caller can narrow by.
+type ReceiptPagedRequestCommand struct {
+	Page     int
+	PageSize int
+	OrderBy  string
+	OrderDir string
+
+	Retailer         string
+	PurchaseDateFrom string
+	PurchaseDateTo   string
+	MinTotal         *decimal.Decimal
+	MaxTotal         *decimal.Decimal
+	MinPoints        *int
+	MaxPoints        *int
+}
+
+// defaultPageSize and maxPageSize bound PageSize when a caller omits it or asks for too much.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// LoadDataFromRequest parses and validates a ReceiptPagedRequestCommand out of c's query
+// parameters, applying defaults for page/pageSize and rejecting malformed filter values.
+func LoadDataFromRequest(c *gin.Context) (ReceiptPagedRequestCommand, error) {
+	cmd := ReceiptPagedRequestCommand{
+		Page:             1,
+		PageSize:         defaultPageSize,
+		OrderDir:         "asc",
+		Retailer:         c.Query("retailer"),
+		PurchaseDateFrom: c.Query("purchaseDateFrom"),
+		PurchaseDateTo:   c.Query("purchaseDateTo"),
+	}
+
+	if v := c.Query("page"); v != "" {
+		page, err := strconv.Atoi(v)
+		if err != nil || page < 1 {
+			return ReceiptPagedRequestCommand{}, fmt.Errorf("invalid page %q", v)
+		}
+		cmd.Page = page
+	}
+
+	if v := c.Query("pageSize"); v != "" {
+		pageSize, err := strconv.Atoi(v)
+		if err != nil || pageSize < 1 || pageSize > maxPageSize {
+			return ReceiptPagedRequestCommand{}, fmt.Errorf("invalid pageSize %q (must be 1-%d)", v, maxPageSize)
+		}
+		cmd.PageSize = pageSize
+	}
+
+	if v := c.Query("orderBy"); v != "" {
+		if !store.OrderableFields[v] {
+			return ReceiptPagedRequestCommand{}, fmt.Errorf("invalid orderBy %q", v)
+		}
+		cmd.OrderBy = v
+	}
+
+	if v := c.Query("orderDir"); v != "" {
+		if v != "asc" && v != "desc" {
+			return ReceiptPagedRequestCommand{}, fmt.Errorf("invalid orderDir %q (must be asc or desc)", v)
+		}
+		cmd.OrderDir = v
+	}
+
+	if v := c.Query("minTotal"); v != "" {
+		amount, err := decimal.NewFromString(v)
+		if err != nil {
+			return ReceiptPagedRequestCommand{}, fmt.Errorf("invalid minTotal %q", v)
+		}
+		cmd.MinTotal = &amount
+	}
+
+	if v := c.Query("maxTotal"); v != "" {
+		amount, err := decimal.NewFromString(v)
+		if err != nil {
+			return ReceiptPagedRequestCommand{}, fmt.Errorf("invalid maxTotal %q", v)
+		}
+		cmd.MaxTotal = &amount
+	}
+
+	if v := c.Query("minPoints"); v != "" {
+		points, err := strconv.Atoi(v)
+		if err != nil {
+			return ReceiptPagedRequestCommand{}, fmt.Errorf("invalid minPoints %q", v)
+		}
+		cmd.MinPoints = &points
+	}
+
+	if v := c.Query("maxPoints"); v != "" {
+		points, err := strconv.Atoi(v)
+		if err != nil {
+			return ReceiptPagedRequestCommand{}, fmt.Errorf("invalid maxPoints %q", v)
+		}
+		cmd.MaxPoints = &points
+	}
+
+	return cmd, nil
+}
+
+// ReceiptSummary is one entry in the GET /receipts response: the stored receipt plus its
+// computed points.
+type ReceiptSummary struct {
+	ID           string `json:"id"`
+	Retailer     string `json:"retailer"`
+	PurchaseDate string `json:"purchaseDate"`
+	PurchaseTime string `json:"purchaseTime"`
+	Total        string `json:"total"`
+	Items        []Item `json:"items"`
+	Points       int    `json:"points"`
+}
+
+// listReceipts handles GET /receipts: paged, filtered receipts with their computed points.
+//
+// MinTotal/MaxTotal and the date range are pushed down to the store so a DB backend can
+// filter and page in SQL. MinPoints/MaxPoints can't be pushed down the same way (points
+// depend on the rule engine, not on a stored column), so they're applied to the page
+// after it comes back; TotalCount reflects the store-level filters only.
+func (a *api) listReceipts(c *gin.Context) {
+	cmd, err := LoadDataFromRequest(c)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	filter := store.Filter{
+		Retailer:         cmd.Retailer,
+		PurchaseDateFrom: cmd.PurchaseDateFrom,
+		PurchaseDateTo:   cmd.PurchaseDateTo,
+		MinTotal:         cmd.MinTotal,
+		MaxTotal:         cmd.MaxTotal,
+	}
+	paging := store.Paging{
+		Page:     cmd.Page,
+		PageSize: cmd.PageSize,
+		OrderBy:  cmd.OrderBy,
+		OrderDir: cmd.OrderDir,
+	}
+
+	receipts, totalCount, err := a.store.List(filter, paging)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"error": "Failed to list receipts"})
+		return
+	}
+
+	items := make([]ReceiptSummary, 0, len(receipts))
+	for _, receipt := range receipts {
+		scored, err := toRulesReceipt(receipt)
+		if err != nil {
+			c.AbortWithStatusJSON(500, gin.H{"error": "Stored receipt has invalid monetary amounts"})
+			return
+		}
+		points, _ := a.rules.Apply(scored)
+
+		if cmd.MinPoints != nil && points < *cmd.MinPoints {
+			continue
+		}
+		if cmd.MaxPoints != nil && points > *cmd.MaxPoints {
+			continue
+		}
+
+		items = append(items, ReceiptSummary{
+			ID:           receipt.ID,
+			Retailer:     receipt.Retailer,
+			PurchaseDate: receipt.PurchaseDate,
+			PurchaseTime: receipt.PurchaseTime,
+			Total:        receipt.Total,
+			Items:        toItems(receipt.Items),
+			Points:       points,
+		})
+	}
+
+	c.IndentedJSON(200, gin.H{"items": items, "totalCount": totalCount})
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+func mustDecimal(t *testing.T, s string) decimal.Decimal {
+	t.Helper()
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		t.Fatalf("decimal.NewFromString(%q) error = %v", s, err)
+	}
+	return d
+}
+
+func TestLoadDataFromRequestDefaults(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := httptest.NewRequest(http.MethodGet, "/receipts", nil)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = req
+
+	cmd, err := LoadDataFromRequest(c)
+	if err != nil {
+		t.Fatalf("LoadDataFromRequest() error = %v", err)
+	}
+	if cmd.Page != 1 || cmd.PageSize != defaultPageSize || cmd.OrderDir != "asc" {
+		t.Errorf("LoadDataFromRequest() = %+v, want page=1 pageSize=%d orderDir=asc", cmd, defaultPageSize)
+	}
+}
+
+func TestLoadDataFromRequestInvalidOrderBy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := httptest.NewRequest(http.MethodGet, "/receipts?orderBy=bogus", nil)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = req
+
+	if _, err := LoadDataFromRequest(c); err == nil {
+		t.Error("LoadDataFromRequest() error = nil, want error for invalid orderBy")
+	}
+}
+
+func TestLoadDataFromRequestFilters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := httptest.NewRequest(http.MethodGet, "/receipts?retailer=Target&minTotal=10.00&maxPoints=50", nil)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = req
+
+	cmd, err := LoadDataFromRequest(c)
+	if err != nil {
+		t.Fatalf("LoadDataFromRequest() error = %v", err)
+	}
+	if cmd.Retailer != "Target" {
+		t.Errorf("Retailer = %q, want Target", cmd.Retailer)
+	}
+	if cmd.MinTotal == nil || !cmd.MinTotal.Equal(mustDecimal(t, "10.00")) {
+		t.Errorf("MinTotal = %v, want 10.00", cmd.MinTotal)
+	}
+	if cmd.MaxPoints == nil || *cmd.MaxPoints != 50 {
+		t.Errorf("MaxPoints = %v, want 50", cmd.MaxPoints)
+	}
+}
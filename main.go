@@ -2,14 +2,19 @@ package main
 
 // Import necessary packages including the gin web framework and uuid for generating random ids
 import (
-	"math"
-	"regexp"
-	"strconv"
-	"strings"
-	"time"
+	"context"
+	"log"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/cache"
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/events"
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/metrics"
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/rules"
+	"github.com/Daniel-Bullock/Receipt-Processor-Webservice/store"
 )
 
 // Defines a struct to represent the receipt JSON
@@ -27,8 +32,17 @@ type Item struct {
 	Price            string `json:"price"`
 }
 
-// Defines a map to store the receipts in memory
-var receipts = make(map[string]Receipt)
+// api holds the dependencies shared by the HTTP handlers. Storage is behind
+// the store.ReceiptStore interface so the backend (memory/Postgres/Mongo)
+// can be swapped via RECEIPT_STORE without touching handler code, and
+// scoring is behind the rules.Engine so rule sets can be reconfigured
+// without touching handler code either.
+type api struct {
+	store  store.ReceiptStore
+	rules  *rules.Engine
+	events events.Bus
+	cache  cache.Cache
+}
 
 // Defines a function to generate a new ID for the receipt
 func generateID() string {
@@ -46,8 +60,8 @@ func generateID() string {
 // gin Context is a structure that contains both the http.Request and the http.Response
 // that a normal http.Handler would use, plus some useful methods and shortcuts to manipulate those
 
-// Takes in a JSON receipt, generates id and stores it in map, and returns a JSON object with the ID
-func processReceipts(c *gin.Context) {
+// Takes in a JSON receipt, generates id and stores it via the ReceiptStore, and returns a JSON object with the ID
+func (a *api) processReceipts(c *gin.Context) {
 	// Parses the JSON payload from the request, stores it in the var receipt
 	var receipt Receipt
 	if err := c.BindJSON(&receipt); err != nil {
@@ -55,43 +69,19 @@ func processReceipts(c *gin.Context) {
 		return
 	}
 
-	// Verify that retailer is not empty and contains only alphabets, numbers, or spaces.
-	if !regexp.MustCompile(`^[A-Za-z0-9\s]+$`).MatchString(receipt.Retailer) {
-		c.AbortWithStatusJSON(400, gin.H{"error": "Invalid retailer name"})
-		return
-	}
-
-	// Ensure that the purchaseDate is a valid date string in the format "yyyy-mm-dd"
-	if _, err := time.Parse("2006-01-02", receipt.PurchaseDate); err != nil {
-		c.AbortWithStatusJSON(400, gin.H{"error": "Invalid purchase date"})
+	// Runs the same field validation the bulk endpoint shares
+	if err := ValidateReceipt(receipt); err != nil {
+		c.AbortWithStatusJSON(400, gin.H{"error": validationMessage(err)})
 		return
 	}
 
-	// Ensure that the purchaseTime is a valid time string in the format "hh:mm"
-	if _, err := time.Parse("15:04", receipt.PurchaseTime); err != nil {
-		c.AbortWithStatusJSON(400, gin.H{"error": "Invalid purchase time"})
+	// Generates a new ID for the receipt and stores it via the configured ReceiptStore
+	id, err := a.saveReceipt(c.Request.Context(), receipt)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"error": "Failed to save receipt"})
 		return
 	}
 
-	// Verify that each item in the "items" array has a non-empty "shortDescription" field and a valid "price" field
-	for i, item := range receipt.Items {
-		if item.ShortDescription == "" {
-			c.AbortWithStatusJSON(400, gin.H{"error": "Item description cannot be empty"})
-			return
-		}
-		if _, err := strconv.ParseFloat(item.Price, 64); err != nil {
-			c.AbortWithStatusJSON(400, gin.H{"error": "Invalid item price"})
-			return
-		}
-		receipt.Items[i] = item
-	}
-
-	// Generates a new ID for the receipt
-	id := generateID()
-
-	// Store the receipt in memory
-	receipts[id] = receipt
-
 	// Returns the ID in the response
 	// Serializes the data as JSON and sends it to the client with an indentation of 4 spaces
 	// Returns a status code of 200 (OK)
@@ -99,90 +89,176 @@ func processReceipts(c *gin.Context) {
 	c.IndentedJSON(200, gin.H{"id": id})
 }
 
-// Helper function converting string to float 64
-func stringToFloat64(total string) float64 {
-	f, _ := strconv.ParseFloat(total, 64)
-	return f
+// validationMessage extracts the human-readable message from a ValidateReceipt error,
+// falling back to its full Error() text for errors of an unexpected type.
+func validationMessage(err error) string {
+	if verr, ok := err.(*ValidationError); ok {
+		return verr.Message
+	}
+	return err.Error()
 }
 
-// Function to calculate the points for a receipt based on the 10 rules
-func calculatePoints(receipt Receipt) int {
-	points := 0
-
-	// Rule 1: One point for every alphanumeric character in the retailer name.
-	for _, c := range receipt.Retailer {
-		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
-			points++
-		}
+// saveReceipt generates a new ID for receipt, stores it via the configured ReceiptStore,
+// and publishes a receipt_processed event.
+func (a *api) saveReceipt(ctx context.Context, receipt Receipt) (string, error) {
+	id := generateID()
+	if _, err := a.store.Save(toStoreReceipt(id, receipt)); err != nil {
+		return "", err
 	}
 
-	// Rule 2: 50 points if the total is a round dollar amount with no cents.
-	if receipt.Total == "0" || receipt.Total[len(receipt.Total)-3:] == ".00" {
-		points += 50
-	}
+	a.events.Publish(ctx, events.Event{
+		Type:      events.TypeReceiptProcessed,
+		ReceiptID: id,
+		Data:      map[string]interface{}{"retailer": receipt.Retailer, "total": receipt.Total},
+	})
 
-	// Rule 3: 25 points if the total is a multiple of 0.25.
-	if receipt.Total == "0" || math.Mod(stringToFloat64(receipt.Total), 0.25) == 0 {
-		points += 25
-	}
+	return id, nil
+}
 
-	// Rule 4: 5 points for every two items on the receipt.
-	points += len(receipt.Items) / 2 * 5
+// toStoreReceipt converts an inbound JSON receipt plus its generated ID into the store's persisted shape.
+func toStoreReceipt(id string, receipt Receipt) store.Receipt {
+	items := make([]store.Item, len(receipt.Items))
+	for i, item := range receipt.Items {
+		items[i] = store.Item{ShortDescription: item.ShortDescription, Price: item.Price}
+	}
+	return store.Receipt{
+		ID:           id,
+		Retailer:     receipt.Retailer,
+		PurchaseDate: receipt.PurchaseDate,
+		PurchaseTime: receipt.PurchaseTime,
+		Total:        receipt.Total,
+		Items:        items,
+	}
+}
 
-	// Rule 5: If the trimmed length of the item description is a multiple of 3, multiply the price by 0.2 and round up to the nearest integer.
-	for _, item := range receipt.Items {
-		if len(strings.TrimSpace(item.ShortDescription))%3 == 0 {
-			points += int(math.Ceil(stringToFloat64(item.Price) * 0.2))
-		}
+// toItems converts a slice of persisted items back into the JSON item shape.
+func toItems(items []store.Item) []Item {
+	out := make([]Item, len(items))
+	for i, item := range items {
+		out[i] = Item{ShortDescription: item.ShortDescription, Price: item.Price}
 	}
+	return out
+}
 
-	//Rule 6: 6 points if the day in the purchase date is odd
-	purchaseDate, err := time.Parse("2006-01-02", receipt.PurchaseDate)
-	if err == nil && purchaseDate.Day()%2 == 1 {
-		points += 6
+// toRulesReceipt converts a persisted receipt into the shape the rules engine scores,
+// re-parsing Total and each item's Price into decimal.Decimal since the store only keeps the raw strings.
+func toRulesReceipt(receipt store.Receipt) (rules.Receipt, error) {
+	total, err := decimal.NewFromString(receipt.Total)
+	if err != nil {
+		return rules.Receipt{}, err
 	}
 
-	//Rule 7: 10 points if the time of purchase is after 2:00pm and before 4:00pm.
-	purchaseTime, err := time.Parse("15:04", receipt.PurchaseTime)
-	if err == nil {
-		hour := purchaseTime.Hour()
-		if hour >= 14 && hour < 16 {
-			points += 10
+	items := make([]rules.Item, len(receipt.Items))
+	for i, item := range receipt.Items {
+		price, err := decimal.NewFromString(item.Price)
+		if err != nil {
+			return rules.Receipt{}, err
 		}
+		items[i] = rules.Item{ShortDescription: item.ShortDescription, Price: price}
 	}
-
-	return points
+	return rules.Receipt{
+		Retailer:     receipt.Retailer,
+		PurchaseDate: receipt.PurchaseDate,
+		PurchaseTime: receipt.PurchaseTime,
+		Total:        total,
+		Items:        items,
+	}, nil
 }
 
-// Getter endpoint that looks up the receipt by the ID and returns an object specifying the points awarded
-func getPoints(c *gin.Context) {
+// Getter endpoint that looks up the receipt by the ID and returns an object specifying the points awarded.
+// Pass ?explain=1 to also get a breakdown of how many points each rule contributed.
+func (a *api) getPoints(c *gin.Context) {
 	// Gets the ID from the URL parameter
 	id := c.Param("id")
 
+	// Points are deterministic per (receipt, rule-set version), so a cache hit
+	// skips the store lookup and rule evaluation entirely.
+	ruleSetVersion := a.rules.Version()
+	if cached, ok := a.cache.Get(id, ruleSetVersion); ok {
+		metrics.PointsCacheHits.Inc()
+		respondPoints(c, cached.Total, cached.Breakdown)
+		return
+	}
+	metrics.PointsCacheMisses.Inc()
+
 	// Looks up the receipt by ID
-	receipt, ok := receipts[id]
-	if !ok {
+	receipt, err := a.store.Get(id)
+	if err != nil {
 		c.AbortWithStatusJSON(404, gin.H{"error": "Receipt not found"})
 		return
 	}
 
-	// Calculates the points for the receipt
-	points := calculatePoints(receipt)
+	// Converts the stored receipt into the shape the rule engine scores
+	scored, err := toRulesReceipt(receipt)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"error": "Stored receipt has invalid monetary amounts"})
+		return
+	}
+
+	// Calculates the points for the receipt using the rule set selected for its retailer
+	points, breakdown := a.rules.Apply(scored)
+	a.cache.Set(id, ruleSetVersion, cache.Points{Total: points, Breakdown: breakdown})
+
+	a.events.Publish(c.Request.Context(), events.Event{
+		Type:      events.TypePointsCalculated,
+		ReceiptID: id,
+		Data:      map[string]interface{}{"points": points, "breakdown": breakdown},
+	})
 
-	// Returns the points in the response
+	respondPoints(c, points, breakdown)
+}
+
+// respondPoints writes the points response, including the per-rule
+// breakdown only if explain=1 was requested.
+func respondPoints(c *gin.Context, points int, breakdown map[string]int) {
+	if c.Query("explain") == "1" {
+		c.IndentedJSON(200, gin.H{"points": points, "breakdown": breakdown})
+		return
+	}
 	c.IndentedJSON(200, gin.H{"points": points})
 }
 
 func main() {
+	// Selects the storage backend from RECEIPT_STORE (memory/postgres/mongo), defaulting to memory
+	receiptStore, err := store.NewStoreFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize receipt store: %v", err)
+	}
+
+	// Loads the points-rule engine from RULES_CONFIG_PATH, defaulting to the built-in rule set
+	ruleEngine, err := rules.NewEngineFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize rule engine: %v", err)
+	}
+
+	// Selects the event bus from REDIS_ADDR, defaulting to a no-op bus
+	eventBus := events.NewBusFromEnv()
+
+	// Selects the points-cache backend from POINTS_CACHE, defaulting to an in-memory LRU
+	pointsCache := cache.NewCacheFromEnv()
+
+	a := &api{store: receiptStore, rules: ruleEngine, events: eventBus, cache: pointsCache}
 
 	//creates a new Gin router, Default() returns a new instance of the gin.Engine struct, which represents the main router of the Gin web framework
 	router := gin.Default()
 
 	// Defines the Process Receipts endpoint
-	router.POST("/receipts/process", processReceipts)
+	router.POST("/receipts/process", a.processReceipts)
+
+	// Defines the bulk Process Receipts endpoint
+	router.POST("/receipts/process/bulk", a.processReceiptsBulk)
 
 	// Defines the Get Points endpoint
-	router.GET("/receipts/:id/points", getPoints)
+	router.GET("/receipts/:id/points", a.getPoints)
+
+	// Defines the paged/filtered List Receipts endpoint
+	router.GET("/receipts", a.listReceipts)
+
+	// Streams receipt lifecycle events (receipt_processed, points_calculated) over a WebSocket
+	router.GET("/receipts/events", a.streamReceiptEvents)
+
+	// Exposes Prometheus metrics, including points_cache_hits_total/points_cache_misses_total
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
 	// Starts the router and listens for HTPP requests on port 8080
 	router.Run("localhost:8080")
@@ -0,0 +1,20 @@
+// Package metrics holds the Prometheus collectors exposed on /metrics.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// PointsCacheHits counts GET /receipts/:id/points requests served from the points cache.
+var PointsCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "points_cache_hits_total",
+	Help: "Total number of points requests served from the points cache.",
+})
+
+// PointsCacheMisses counts GET /receipts/:id/points requests that recomputed
+// points because of a cache miss.
+var PointsCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "points_cache_misses_total",
+	Help: "Total number of points requests that recomputed points because of a cache miss.",
+})
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// alphanumericRetailerRule awards one point per alphanumeric character in the retailer name.
+type alphanumericRetailerRule struct{}
+
+func (alphanumericRetailerRule) Name() string { return "alphanumeric_retailer" }
+
+func (alphanumericRetailerRule) Apply(receipt Receipt) int {
+	points := 0
+	for _, c := range receipt.Retailer {
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			points++
+		}
+	}
+	return points
+}
+
+// roundDollarRule awards Points if the total has no cents.
+type roundDollarRule struct {
+	Points int
+}
+
+func (roundDollarRule) Name() string { return "round_dollar" }
+
+func (r roundDollarRule) Apply(receipt Receipt) int {
+	if receipt.Total.IsInteger() {
+		return r.Points
+	}
+	return 0
+}
+
+// quarterMultipleRule awards Points if the total is a multiple of Quarter.
+type quarterMultipleRule struct {
+	Points  int
+	Quarter decimal.Decimal
+}
+
+func (quarterMultipleRule) Name() string { return "quarter_multiple" }
+
+func (r quarterMultipleRule) Apply(receipt Receipt) int {
+	if receipt.Total.Mod(r.Quarter).IsZero() {
+		return r.Points
+	}
+	return 0
+}
+
+// itemPairRule awards PointsPerPair points for every ItemsPerPair items on the receipt.
+type itemPairRule struct {
+	ItemsPerPair  int
+	PointsPerPair int
+}
+
+func (itemPairRule) Name() string { return "item_pair" }
+
+func (r itemPairRule) Apply(receipt Receipt) int {
+	return len(receipt.Items) / r.ItemsPerPair * r.PointsPerPair
+}
+
+// itemDescriptionMultipleRule awards ceil(price * Rate) points for every item whose
+// trimmed description length is a multiple of DescriptionMultiple.
+type itemDescriptionMultipleRule struct {
+	DescriptionMultiple int
+	Rate                decimal.Decimal
+}
+
+func (itemDescriptionMultipleRule) Name() string { return "item_description_multiple" }
+
+func (r itemDescriptionMultipleRule) Apply(receipt Receipt) int {
+	points := 0
+	for _, item := range receipt.Items {
+		if len(strings.TrimSpace(item.ShortDescription))%r.DescriptionMultiple == 0 {
+			points += int(item.Price.Mul(r.Rate).Ceil().IntPart())
+		}
+	}
+	return points
+}
+
+// oddPurchaseDayRule awards Points if the day of PurchaseDate is odd.
+type oddPurchaseDayRule struct {
+	Points int
+}
+
+func (oddPurchaseDayRule) Name() string { return "odd_purchase_day" }
+
+func (r oddPurchaseDayRule) Apply(receipt Receipt) int {
+	purchaseDate, err := time.Parse("2006-01-02", receipt.PurchaseDate)
+	if err == nil && purchaseDate.Day()%2 == 1 {
+		return r.Points
+	}
+	return 0
+}
+
+// afternoonPurchaseRule awards Points if PurchaseTime falls in [StartHour, EndHour).
+type afternoonPurchaseRule struct {
+	StartHour int
+	EndHour   int
+	Points    int
+}
+
+func (afternoonPurchaseRule) Name() string { return "afternoon_purchase" }
+
+func (r afternoonPurchaseRule) Apply(receipt Receipt) int {
+	purchaseTime, err := time.Parse("15:04", receipt.PurchaseTime)
+	if err != nil {
+		return 0
+	}
+	hour := purchaseTime.Hour()
+	if hour >= r.StartHour && hour < r.EndHour {
+		return r.Points
+	}
+	return 0
+}
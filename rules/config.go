@@ -0,0 +1,271 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+// RuleConfig describes one rule in a RuleSetConfig. Params are interpreted
+// by the rule named by Name; unrecognized or missing params fall back to
+// that rule's default.
+type RuleConfig struct {
+	Name   string                 `yaml:"name"`
+	Params map[string]interface{} `yaml:"params"`
+}
+
+// RuleSetConfig is a named, ordered list of rules.
+type RuleSetConfig struct {
+	Name  string       `yaml:"name"`
+	Rules []RuleConfig `yaml:"rules"`
+}
+
+// RetailerOverride routes receipts whose Retailer matches Pattern (a regular
+// expression) to RuleSet instead of the default rule set. Overrides are
+// matched in order and the first match wins.
+type RetailerOverride struct {
+	Pattern string `yaml:"pattern"`
+	RuleSet string `yaml:"ruleSet"`
+}
+
+// Config is the on-disk shape of a rule-engine configuration file.
+type Config struct {
+	Default   RuleSetConfig      `yaml:"default"`
+	RuleSets  []RuleSetConfig    `yaml:"ruleSets"`
+	Overrides []RetailerOverride `yaml:"overrides"`
+}
+
+// LoadConfigFile reads and parses a YAML (or JSON, which is valid YAML)
+// rule-engine configuration from path.
+func LoadConfigFile(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("read rules config: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parse rules config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Engine dispatches a Receipt to the RuleEngine selected by its retailer,
+// falling back to the default rule set when no override matches. Engine is
+// safe for concurrent use, including concurrent calls to Reload.
+type Engine struct {
+	mu      sync.RWMutex
+	state   *engineState
+	version uint64
+}
+
+// engineState is everything Reload swaps atomically when the rule
+// configuration changes.
+type engineState struct {
+	defaultEngine *RuleEngine
+	ruleSets      map[string]*RuleEngine
+	overrides     []compiledOverride
+}
+
+type compiledOverride struct {
+	pattern *regexp.Regexp
+	ruleSet string
+}
+
+// NewEngine builds an Engine from cfg, compiling every rule and retailer
+// override up front so Apply never fails at request time.
+func NewEngine(cfg Config) (*Engine, error) {
+	state, err := buildEngineState(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Engine{state: state, version: 1}, nil
+}
+
+// buildEngineState compiles cfg into an engineState.
+func buildEngineState(cfg Config) (*engineState, error) {
+	defaultEngine, err := buildRuleEngine(cfg.Default)
+	if err != nil {
+		return nil, fmt.Errorf("build default rule set: %w", err)
+	}
+
+	ruleSets := make(map[string]*RuleEngine, len(cfg.RuleSets))
+	for _, ruleSetCfg := range cfg.RuleSets {
+		engine, err := buildRuleEngine(ruleSetCfg)
+		if err != nil {
+			return nil, fmt.Errorf("build rule set %q: %w", ruleSetCfg.Name, err)
+		}
+		ruleSets[ruleSetCfg.Name] = engine
+	}
+
+	overrides := make([]compiledOverride, len(cfg.Overrides))
+	for i, o := range cfg.Overrides {
+		pattern, err := regexp.Compile(o.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("compile override pattern %q: %w", o.Pattern, err)
+		}
+		if _, ok := ruleSets[o.RuleSet]; !ok {
+			return nil, fmt.Errorf("override references unknown rule set %q", o.RuleSet)
+		}
+		overrides[i] = compiledOverride{pattern: pattern, ruleSet: o.RuleSet}
+	}
+
+	return &engineState{defaultEngine: defaultEngine, ruleSets: ruleSets, overrides: overrides}, nil
+}
+
+// Reload recompiles the engine from cfg and swaps it in atomically,
+// bumping Version() so callers caching points by (receiptID, Version())
+// naturally stop hitting entries computed under the old rules.
+func (e *Engine) Reload(cfg Config) error {
+	state, err := buildEngineState(cfg)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.state = state
+	e.version++
+	return nil
+}
+
+// Version returns a counter that increments every time Reload swaps in a
+// new configuration. It never repeats for the lifetime of the process, so
+// it's suitable as part of a cache key for values derived from the rules.
+func (e *Engine) Version() uint64 {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.version
+}
+
+// NewEngineFromEnv builds an Engine from the file named by RULES_CONFIG_PATH,
+// or from DefaultConfig (the original 7 scoring rules) if that variable is unset.
+func NewEngineFromEnv() (*Engine, error) {
+	path := os.Getenv("RULES_CONFIG_PATH")
+	if path == "" {
+		return NewEngine(DefaultConfig())
+	}
+
+	cfg, err := LoadConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewEngine(cfg)
+}
+
+// selectRuleSet returns the RuleEngine for retailer: the rule set of the
+// first matching override, or the default rule set if none match.
+func (s *engineState) selectRuleSet(retailer string) *RuleEngine {
+	for _, o := range s.overrides {
+		if o.pattern.MatchString(retailer) {
+			return s.ruleSets[o.ruleSet]
+		}
+	}
+	return s.defaultEngine
+}
+
+// Apply scores receipt using the rule set selected for its retailer.
+func (e *Engine) Apply(receipt Receipt) (int, map[string]int) {
+	e.mu.RLock()
+	state := e.state
+	e.mu.RUnlock()
+	return state.selectRuleSet(receipt.Retailer).Apply(receipt)
+}
+
+// buildRuleEngine constructs a RuleEngine from a RuleSetConfig, resolving each
+// named rule via buildRule.
+func buildRuleEngine(cfg RuleSetConfig) (*RuleEngine, error) {
+	built := make([]Rule, len(cfg.Rules))
+	for i, ruleCfg := range cfg.Rules {
+		rule, err := buildRule(ruleCfg)
+		if err != nil {
+			return nil, err
+		}
+		built[i] = rule
+	}
+	return &RuleEngine{Name: cfg.Name, Rules: built}, nil
+}
+
+// buildRule constructs the Rule named by cfg.Name, applying defaults for any
+// param not present in cfg.Params. Params that would make the rule panic or
+// misbehave at Apply time (a zero divisor, a zero modulus) are rejected here
+// instead, so a bad config fails to load rather than failing mid-request.
+func buildRule(cfg RuleConfig) (Rule, error) {
+	switch cfg.Name {
+	case "alphanumeric_retailer":
+		return alphanumericRetailerRule{}, nil
+	case "round_dollar":
+		return roundDollarRule{Points: intParam(cfg.Params, "points", 50)}, nil
+	case "quarter_multiple":
+		quarter := decimalParam(cfg.Params, "quarter", decimal.NewFromFloat(0.25))
+		if quarter.IsZero() {
+			return nil, fmt.Errorf("quarter_multiple: quarter must not be zero")
+		}
+		return quarterMultipleRule{
+			Points:  intParam(cfg.Params, "points", 25),
+			Quarter: quarter,
+		}, nil
+	case "item_pair":
+		itemsPerPair := intParam(cfg.Params, "itemsPerPair", 2)
+		if itemsPerPair <= 0 {
+			return nil, fmt.Errorf("item_pair: itemsPerPair must be positive, got %d", itemsPerPair)
+		}
+		return itemPairRule{
+			ItemsPerPair:  itemsPerPair,
+			PointsPerPair: intParam(cfg.Params, "pointsPerPair", 5),
+		}, nil
+	case "item_description_multiple":
+		descriptionMultiple := intParam(cfg.Params, "descriptionMultiple", 3)
+		if descriptionMultiple <= 0 {
+			return nil, fmt.Errorf("item_description_multiple: descriptionMultiple must be positive, got %d", descriptionMultiple)
+		}
+		return itemDescriptionMultipleRule{
+			DescriptionMultiple: descriptionMultiple,
+			Rate:                decimalParam(cfg.Params, "rate", decimal.NewFromFloat(0.2)),
+		}, nil
+	case "odd_purchase_day":
+		return oddPurchaseDayRule{Points: intParam(cfg.Params, "points", 6)}, nil
+	case "afternoon_purchase":
+		return afternoonPurchaseRule{
+			StartHour: intParam(cfg.Params, "startHour", 14),
+			EndHour:   intParam(cfg.Params, "endHour", 16),
+			Points:    intParam(cfg.Params, "points", 10),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown rule %q", cfg.Name)
+	}
+}
+
+// intParam reads key from params as an int, returning fallback if the key is
+// absent (YAML numbers decode as int or float64 depending on how they're written).
+func intParam(params map[string]interface{}, key string, fallback int) int {
+	switch v := params[key].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	default:
+		return fallback
+	}
+}
+
+// decimalParam reads key from params as a decimal.Decimal, returning fallback if absent.
+func decimalParam(params map[string]interface{}, key string, fallback decimal.Decimal) decimal.Decimal {
+	switch v := params[key].(type) {
+	case int:
+		return decimal.NewFromInt(int64(v))
+	case float64:
+		return decimal.NewFromFloat(v)
+	case string:
+		if parsed, err := decimal.NewFromString(v); err == nil {
+			return parsed
+		}
+		return fallback
+	default:
+		return fallback
+	}
+}
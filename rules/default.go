@@ -0,0 +1,20 @@
+package rules
+
+// DefaultConfig is the rule set used when no RULES_CONFIG_PATH is configured:
+// the original 10 (actually 7) scoring rules, unchanged, with no retailer overrides.
+func DefaultConfig() Config {
+	return Config{
+		Default: RuleSetConfig{
+			Name: "default",
+			Rules: []RuleConfig{
+				{Name: "alphanumeric_retailer"},
+				{Name: "round_dollar"},
+				{Name: "quarter_multiple"},
+				{Name: "item_pair"},
+				{Name: "item_description_multiple"},
+				{Name: "odd_purchase_day"},
+				{Name: "afternoon_purchase"},
+			},
+		},
+	}
+}
@@ -0,0 +1,194 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func mustDecimal(t *testing.T, s string) decimal.Decimal {
+	t.Helper()
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		t.Fatalf("decimal.NewFromString(%q) error = %v", s, err)
+	}
+	return d
+}
+
+func TestDefaultEngineRoundDollarAndQuarterRules(t *testing.T) {
+	engine, err := NewEngine(DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewEngine() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		total string
+		want  int // points from round_dollar + quarter_multiple only
+	}{
+		{name: "round dollar", total: "9.00", want: 75},
+		{name: "quarter multiple only", total: "9.25", want: 25},
+		{name: "pathological float error case", total: "9.30", want: 0},
+		{name: "small non-quarter total", total: "0.30", want: 0},
+		{name: "large total", total: "12345.67", want: 0},
+		{name: "large round total", total: "12345.00", want: 75},
+		{name: "zero", total: "0", want: 75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receipt := Receipt{
+				PurchaseDate: "2022-01-02", // even day, avoids odd_purchase_day noise
+				PurchaseTime: "10:00",      // outside the 2-4pm window, avoids afternoon_purchase noise
+				Total:        mustDecimal(t, tt.total),
+			}
+
+			points, breakdown := engine.Apply(receipt)
+			if points != tt.want {
+				t.Errorf("Apply() points = %d, want %d (breakdown %v)", points, tt.want, breakdown)
+			}
+		})
+	}
+}
+
+func TestDefaultEngineItemDescriptionRule(t *testing.T) {
+	engine, err := NewEngine(DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewEngine() error = %v", err)
+	}
+
+	receipt := Receipt{
+		PurchaseDate: "2022-01-02",
+		PurchaseTime: "10:00",
+		Total:        mustDecimal(t, "1.00"),
+		Items: []Item{
+			// "abc" has trimmed length 3, so its price contributes ceil(price * 0.2).
+			{ShortDescription: "abc", Price: mustDecimal(t, "9.30")},
+		},
+	}
+
+	points, breakdown := engine.Apply(receipt)
+
+	// round_dollar (50) + quarter_multiple (25) + ceil(9.30 * 0.2) = ceil(1.86) = 2
+	want := 50 + 25 + 2
+	if points != want {
+		t.Errorf("Apply() points = %d, want %d (breakdown %v)", points, want, breakdown)
+	}
+	if breakdown["item_description_multiple"] != 2 {
+		t.Errorf("breakdown[item_description_multiple] = %d, want 2", breakdown["item_description_multiple"])
+	}
+}
+
+func TestEngineRetailerOverride(t *testing.T) {
+	cfg := Config{
+		Default: RuleSetConfig{
+			Name:  "default",
+			Rules: []RuleConfig{{Name: "afternoon_purchase"}},
+		},
+		RuleSets: []RuleSetConfig{
+			{
+				Name: "extended-afternoon",
+				Rules: []RuleConfig{
+					{Name: "afternoon_purchase", Params: map[string]interface{}{
+						"startHour": 13,
+						"endHour":   18,
+						"points":    15,
+					}},
+				},
+			},
+		},
+		Overrides: []RetailerOverride{
+			{Pattern: "(?i)target", RuleSet: "extended-afternoon"},
+		},
+	}
+
+	engine, err := NewEngine(cfg)
+	if err != nil {
+		t.Fatalf("NewEngine() error = %v", err)
+	}
+
+	receipt := Receipt{Retailer: "Target", PurchaseTime: "17:00", Total: mustDecimal(t, "1.00")}
+	points, _ := engine.Apply(receipt)
+	if points != 15 {
+		t.Errorf("Apply() points for overridden retailer = %d, want 15", points)
+	}
+
+	receipt.Retailer = "Walmart"
+	points, _ = engine.Apply(receipt)
+	if points != 0 {
+		t.Errorf("Apply() points for non-overridden retailer = %d, want 0", points)
+	}
+}
+
+func TestEngineReloadBumpsVersionAndAppliesNewRules(t *testing.T) {
+	engine, err := NewEngine(Config{
+		Default: RuleSetConfig{Rules: []RuleConfig{{Name: "round_dollar", Params: map[string]interface{}{"points": 50}}}},
+	})
+	if err != nil {
+		t.Fatalf("NewEngine() error = %v", err)
+	}
+
+	if v := engine.Version(); v != 1 {
+		t.Fatalf("Version() = %d, want 1", v)
+	}
+
+	receipt := Receipt{Total: mustDecimal(t, "9.00")}
+	if points, _ := engine.Apply(receipt); points != 50 {
+		t.Fatalf("Apply() points = %d, want 50", points)
+	}
+
+	err = engine.Reload(Config{
+		Default: RuleSetConfig{Rules: []RuleConfig{{Name: "round_dollar", Params: map[string]interface{}{"points": 10}}}},
+	})
+	if err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	if v := engine.Version(); v != 2 {
+		t.Errorf("Version() after Reload = %d, want 2", v)
+	}
+	if points, _ := engine.Apply(receipt); points != 10 {
+		t.Errorf("Apply() points after Reload = %d, want 10", points)
+	}
+}
+
+func TestNewEngineUnknownRuleSet(t *testing.T) {
+	cfg := Config{
+		Default: RuleSetConfig{Name: "default"},
+		Overrides: []RetailerOverride{
+			{Pattern: ".*", RuleSet: "does-not-exist"},
+		},
+	}
+
+	if _, err := NewEngine(cfg); err == nil {
+		t.Error("NewEngine() error = nil, want error for unknown rule set")
+	}
+}
+
+func TestNewEngineRejectsPanicProneConstants(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		rule   string
+	}{
+		{name: "itemsPerPair zero", rule: "item_pair", params: map[string]interface{}{"itemsPerPair": 0}},
+		{name: "itemsPerPair negative", rule: "item_pair", params: map[string]interface{}{"itemsPerPair": -1}},
+		{name: "descriptionMultiple zero", rule: "item_description_multiple", params: map[string]interface{}{"descriptionMultiple": 0}},
+		{name: "quarter zero", rule: "quarter_multiple", params: map[string]interface{}{"quarter": 0.0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{
+				Default: RuleSetConfig{
+					Name:  "default",
+					Rules: []RuleConfig{{Name: tt.rule, Params: tt.params}},
+				},
+			}
+
+			if _, err := NewEngine(cfg); err == nil {
+				t.Errorf("NewEngine() error = nil, want error for %s", tt.name)
+			}
+		})
+	}
+}
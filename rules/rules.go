@@ -0,0 +1,49 @@
+// Package rules implements the points-rule engine: an ordered, configurable
+// set of scoring rules that can be swapped or tuned without touching the
+// HTTP handlers in main.
+package rules
+
+import "github.com/shopspring/decimal"
+
+// Receipt is the input a Rule scores. Amounts are already parsed to
+// decimal.Decimal so no rule needs to redo binary-float math on currency.
+type Receipt struct {
+	Retailer     string
+	PurchaseDate string // yyyy-mm-dd
+	PurchaseTime string // hh:mm
+	Items        []Item
+	Total        decimal.Decimal
+}
+
+// Item is a single line item on a Receipt.
+type Item struct {
+	ShortDescription string
+	Price            decimal.Decimal
+}
+
+// Rule is a single scoring rule. Apply returns the points receipt earns
+// under this rule alone, so a RuleEngine can report a per-rule breakdown.
+type Rule interface {
+	Name() string
+	Apply(Receipt) int
+}
+
+// RuleEngine scores a Receipt by running an ordered list of rules and
+// summing their points.
+type RuleEngine struct {
+	Name  string
+	Rules []Rule
+}
+
+// Apply runs every rule in the engine against receipt, returning the total
+// points and a breakdown keyed by rule name.
+func (e *RuleEngine) Apply(receipt Receipt) (int, map[string]int) {
+	breakdown := make(map[string]int, len(e.Rules))
+	total := 0
+	for _, rule := range e.Rules {
+		points := rule.Apply(receipt)
+		breakdown[rule.Name()] = points
+		total += points
+	}
+	return total, breakdown
+}
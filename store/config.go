@@ -0,0 +1,39 @@
+package store
+
+import (
+	"fmt"
+	"os"
+)
+
+// NewStoreFromEnv selects and constructs a ReceiptStore based on the
+// RECEIPT_STORE environment variable ("memory", "postgres", or "mongo"),
+// defaulting to "memory" so the service runs with zero configuration.
+//
+//   - memory: no further configuration needed.
+//   - postgres: reads the connection string from RECEIPT_STORE_DSN.
+//   - mongo: reads the connection URI from RECEIPT_STORE_URI and the
+//     database name from RECEIPT_STORE_DB (defaults to "receipts").
+func NewStoreFromEnv() (ReceiptStore, error) {
+	switch backend := os.Getenv("RECEIPT_STORE"); backend {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "postgres":
+		dsn := os.Getenv("RECEIPT_STORE_DSN")
+		if dsn == "" {
+			return nil, fmt.Errorf("RECEIPT_STORE_DSN must be set when RECEIPT_STORE=postgres")
+		}
+		return NewPostgresStore(dsn)
+	case "mongo":
+		uri := os.Getenv("RECEIPT_STORE_URI")
+		if uri == "" {
+			return nil, fmt.Errorf("RECEIPT_STORE_URI must be set when RECEIPT_STORE=mongo")
+		}
+		dbName := os.Getenv("RECEIPT_STORE_DB")
+		if dbName == "" {
+			dbName = "receipts"
+		}
+		return NewMongoStore(uri, dbName)
+	default:
+		return nil, fmt.Errorf("unknown RECEIPT_STORE backend %q (want memory, postgres, or mongo)", backend)
+	}
+}
@@ -0,0 +1,167 @@
+package store
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// likeEscaper escapes LIKE wildcards so a retailer filter matches as a literal substring.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// receiptModel is the GORM row for the "receipts" table. Items are kept in
+// a separate table and associated by ReceiptID so a receipt's items can be
+// queried and indexed independently of the parent row.
+type receiptModel struct {
+	ID           string `gorm:"primaryKey"`
+	Retailer     string
+	PurchaseDate string
+	PurchaseTime string
+	Total        string
+	Items        []itemModel `gorm:"foreignKey:ReceiptID"`
+}
+
+func (receiptModel) TableName() string { return "receipts" }
+
+// itemModel is the GORM row for the "items" table.
+type itemModel struct {
+	ID               uint `gorm:"primaryKey"`
+	ReceiptID        string
+	ShortDescription string
+	Price            string
+}
+
+func (itemModel) TableName() string { return "items" }
+
+// gormStore implements ReceiptStore on top of any GORM dialect (Postgres,
+// SQLite, ...). Postgres and SQLite only differ in how the *gorm.DB is
+// opened, so they share this implementation.
+type gormStore struct {
+	db *gorm.DB
+}
+
+// migrate creates/updates the receipts and items tables for db.
+func migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&receiptModel{}, &itemModel{})
+}
+
+func toReceiptModel(r Receipt) receiptModel {
+	items := make([]itemModel, len(r.Items))
+	for i, item := range r.Items {
+		items[i] = itemModel{ReceiptID: r.ID, ShortDescription: item.ShortDescription, Price: item.Price}
+	}
+	return receiptModel{
+		ID:           r.ID,
+		Retailer:     r.Retailer,
+		PurchaseDate: r.PurchaseDate,
+		PurchaseTime: r.PurchaseTime,
+		Total:        r.Total,
+		Items:        items,
+	}
+}
+
+func fromReceiptModel(m receiptModel) Receipt {
+	items := make([]Item, len(m.Items))
+	for i, item := range m.Items {
+		items[i] = Item{ShortDescription: item.ShortDescription, Price: item.Price}
+	}
+	return Receipt{
+		ID:           m.ID,
+		Retailer:     m.Retailer,
+		PurchaseDate: m.PurchaseDate,
+		PurchaseTime: m.PurchaseTime,
+		Total:        m.Total,
+		Items:        items,
+	}
+}
+
+// Save upserts receipt and its items.
+func (s *gormStore) Save(receipt Receipt) (string, error) {
+	model := toReceiptModel(receipt)
+	if err := s.db.Save(&model).Error; err != nil {
+		return "", err
+	}
+	return model.ID, nil
+}
+
+// Get loads a receipt and its items by ID.
+func (s *gormStore) Get(id string) (Receipt, error) {
+	var model receiptModel
+	if err := s.db.Preload("Items").First(&model, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return Receipt{}, ErrNotFound
+		}
+		return Receipt{}, err
+	}
+	return fromReceiptModel(model), nil
+}
+
+// List runs filter and paging as SQL, rather than loading every row. Total is
+// stored as TEXT, so min/max total comparisons and ordering by total cast it
+// to a numeric type; this loses precision on very large totals, but covers
+// realistic receipts.
+func (s *gormStore) List(filter Filter, paging Paging) ([]Receipt, int, error) {
+	query := s.db.Model(&receiptModel{})
+	if filter.Retailer != "" {
+		escaped := likeEscaper.Replace(filter.Retailer)
+		query = query.Where("LOWER(retailer) LIKE LOWER(?) ESCAPE '\\'", "%"+escaped+"%")
+	}
+	if filter.PurchaseDateFrom != "" {
+		query = query.Where("purchase_date >= ?", filter.PurchaseDateFrom)
+	}
+	if filter.PurchaseDateTo != "" {
+		query = query.Where("purchase_date <= ?", filter.PurchaseDateTo)
+	}
+	if filter.MinTotal != nil {
+		query = query.Where("CAST(total AS REAL) >= ?", filter.MinTotal.InexactFloat64())
+	}
+	if filter.MaxTotal != nil {
+		query = query.Where("CAST(total AS REAL) <= ?", filter.MaxTotal.InexactFloat64())
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if paging.OrderBy != "" && OrderableFields[paging.OrderBy] {
+		direction := "ASC"
+		if paging.OrderDir == "desc" {
+			direction = "DESC"
+		}
+		column := toSnakeCaseColumn(paging.OrderBy)
+		if paging.OrderBy == "total" {
+			column = "CAST(total AS REAL)"
+		}
+		query = query.Order(column + " " + direction)
+	}
+
+	if paging.PageSize > 0 {
+		query = query.Limit(paging.PageSize).Offset((paging.Page - 1) * paging.PageSize)
+	}
+
+	var models []receiptModel
+	if err := query.Preload("Items").Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	receipts := make([]Receipt, len(models))
+	for i, model := range models {
+		receipts[i] = fromReceiptModel(model)
+	}
+	return receipts, int(total), nil
+}
+
+// toSnakeCaseColumn maps an OrderableFields key to its receipts column name.
+// Callers ordering by "total" should use the CAST expression in List instead,
+// since total is stored as TEXT and must be sorted numerically.
+func toSnakeCaseColumn(orderBy string) string {
+	switch orderBy {
+	case "purchaseDate":
+		return "purchase_date"
+	case "purchaseTime":
+		return "purchase_time"
+	default:
+		return orderBy // "retailer" and "total" are already valid column names
+	}
+}
@@ -0,0 +1,142 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// newTestGormStore returns a gormStore backed by an ephemeral in-memory
+// SQLite database, migrated and ready to use. SQLite shares the gormStore
+// implementation with Postgres, so this exercises the same List SQL without
+// requiring a running Postgres instance.
+func newTestGormStore(t *testing.T) *gormStore {
+	t.Helper()
+
+	s, err := NewSQLiteStore(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteStore() error = %v", err)
+	}
+	return s
+}
+
+func TestGormStoreSaveAndGet(t *testing.T) {
+	s := newTestGormStore(t)
+
+	receipt := Receipt{
+		ID:       "abc-123",
+		Retailer: "Target",
+		Total:    "9.30",
+		Items:    []Item{{ShortDescription: "Pepsi", Price: "1.25"}},
+	}
+	if _, err := s.Save(receipt); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := s.Get("abc-123")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Retailer != "Target" || len(got.Items) != 1 || got.Items[0].ShortDescription != "Pepsi" {
+		t.Errorf("Get() = %+v, want Retailer=Target with one Pepsi item", got)
+	}
+}
+
+func TestGormStoreGetNotFound(t *testing.T) {
+	s := newTestGormStore(t)
+
+	if _, err := s.Get("missing"); err != ErrNotFound {
+		t.Errorf("Get() error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestGormStoreListFilterAndPage(t *testing.T) {
+	s := newTestGormStore(t)
+	s.Save(Receipt{ID: "1", Retailer: "Target", PurchaseDate: "2022-01-01", Total: "5.00"})
+	s.Save(Receipt{ID: "2", Retailer: "Walmart", PurchaseDate: "2022-06-15", Total: "15.00"})
+	s.Save(Receipt{ID: "3", Retailer: "Target Express", PurchaseDate: "2022-12-31", Total: "25.00"})
+
+	results, total, err := s.List(Filter{Retailer: "target"}, Paging{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 2 || len(results) != 2 {
+		t.Errorf("List() = %d results (total %d), want 2 (total 2)", len(results), total)
+	}
+
+	results, total, err = s.List(Filter{}, Paging{Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 3 || len(results) != 2 {
+		t.Errorf("List() = %d results (total %d), want 2 (total 3)", len(results), total)
+	}
+
+	minTotal := decimal.NewFromFloat(10)
+	results, total, err = s.List(Filter{MinTotal: &minTotal}, Paging{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 2 {
+		t.Errorf("List() minTotal filter returned %d results, want 2", total)
+	}
+}
+
+// TestGormStoreListOrderByTotal proves total sorts numerically rather than
+// lexicographically: "5.00" < "15.00" < "100.00" would come out
+// "100.00", "15.00", "5.00" under a plain TEXT ORDER BY.
+func TestGormStoreListOrderByTotal(t *testing.T) {
+	s := newTestGormStore(t)
+	s.Save(Receipt{ID: "1", Total: "5.00"})
+	s.Save(Receipt{ID: "2", Total: "100.00"})
+	s.Save(Receipt{ID: "3", Total: "15.00"})
+
+	results, _, err := s.List(Filter{}, Paging{OrderBy: "total", OrderDir: "asc"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	gotOrder := []string{results[0].ID, results[1].ID, results[2].ID}
+	wantOrder := []string{"1", "3", "2"}
+	for i := range wantOrder {
+		if gotOrder[i] != wantOrder[i] {
+			t.Errorf("List() order = %v, want %v", gotOrder, wantOrder)
+			break
+		}
+	}
+
+	results, _, err = s.List(Filter{}, Paging{OrderBy: "total", OrderDir: "desc"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	gotOrder = []string{results[0].ID, results[1].ID, results[2].ID}
+	wantOrder = []string{"2", "3", "1"}
+	for i := range wantOrder {
+		if gotOrder[i] != wantOrder[i] {
+			t.Errorf("List() desc order = %v, want %v", gotOrder, wantOrder)
+			break
+		}
+	}
+}
+
+func TestGormStoreListOrderByPurchaseDateAndRetailer(t *testing.T) {
+	s := newTestGormStore(t)
+	s.Save(Receipt{ID: "1", Retailer: "Walmart", PurchaseDate: "2022-06-15"})
+	s.Save(Receipt{ID: "2", Retailer: "Costco", PurchaseDate: "2022-01-01"})
+	s.Save(Receipt{ID: "3", Retailer: "Target", PurchaseDate: "2022-12-31"})
+
+	results, _, err := s.List(Filter{}, Paging{OrderBy: "purchaseDate"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if results[0].ID != "2" || results[2].ID != "3" {
+		t.Errorf("List() purchaseDate order = %v, want 2,1,3", []string{results[0].ID, results[1].ID, results[2].ID})
+	}
+
+	results, _, err = s.List(Filter{}, Paging{OrderBy: "retailer"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if results[0].ID != "2" || results[2].ID != "1" {
+		t.Errorf("List() retailer order = %v, want 2,3,1", []string{results[0].ID, results[1].ID, results[2].ID})
+	}
+}
@@ -0,0 +1,136 @@
+package store
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// MemoryStore is the original in-memory ReceiptStore, kept as the default
+// so the service still runs with zero configuration.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	receipts map[string]Receipt
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{receipts: make(map[string]Receipt)}
+}
+
+// Save stores receipt under its ID, overwriting any existing receipt.
+func (s *MemoryStore) Save(receipt Receipt) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.receipts[receipt.ID] = receipt
+	return receipt.ID, nil
+}
+
+// Get looks up a receipt by ID.
+func (s *MemoryStore) Get(id string) (Receipt, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	receipt, ok := s.receipts[id]
+	if !ok {
+		return Receipt{}, ErrNotFound
+	}
+	return receipt, nil
+}
+
+// List filters and pages over the in-memory receipts. Without an explicit
+// Paging.OrderBy, results come back in map iteration order, which Go does
+// not guarantee, so callers that need a stable default order should prefer
+// a DB-backed store.
+func (s *MemoryStore) List(filter Filter, paging Paging) ([]Receipt, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := make([]Receipt, 0, len(s.receipts))
+	for _, receipt := range s.receipts {
+		if !matchesFilter(receipt, filter) {
+			continue
+		}
+		matched = append(matched, receipt)
+	}
+
+	if paging.OrderBy != "" {
+		sortReceipts(matched, paging.OrderBy, paging.OrderDir)
+	}
+
+	total := len(matched)
+
+	if paging.PageSize <= 0 {
+		return matched, total, nil
+	}
+
+	start := (paging.Page - 1) * paging.PageSize
+	if start < 0 || start >= total {
+		return []Receipt{}, total, nil
+	}
+
+	end := start + paging.PageSize
+	if end > total {
+		end = total
+	}
+
+	return matched[start:end], total, nil
+}
+
+// matchesFilter reports whether receipt satisfies every constraint in filter.
+func matchesFilter(receipt Receipt, filter Filter) bool {
+	if filter.Retailer != "" && !strings.Contains(strings.ToLower(receipt.Retailer), strings.ToLower(filter.Retailer)) {
+		return false
+	}
+	if filter.PurchaseDateFrom != "" && receipt.PurchaseDate < filter.PurchaseDateFrom {
+		return false
+	}
+	if filter.PurchaseDateTo != "" && receipt.PurchaseDate > filter.PurchaseDateTo {
+		return false
+	}
+
+	if filter.MinTotal != nil || filter.MaxTotal != nil {
+		total, err := decimal.NewFromString(receipt.Total)
+		if err != nil {
+			return false
+		}
+		if filter.MinTotal != nil && total.LessThan(*filter.MinTotal) {
+			return false
+		}
+		if filter.MaxTotal != nil && total.GreaterThan(*filter.MaxTotal) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortReceipts sorts receipts in place by orderBy ("purchaseDate", "purchaseTime",
+// "retailer", or "total"), in orderDir ("asc" or "desc", default "asc").
+func sortReceipts(receipts []Receipt, orderBy, orderDir string) {
+	less := func(i, j int) bool {
+		switch orderBy {
+		case "purchaseDate":
+			return receipts[i].PurchaseDate < receipts[j].PurchaseDate
+		case "purchaseTime":
+			return receipts[i].PurchaseTime < receipts[j].PurchaseTime
+		case "retailer":
+			return receipts[i].Retailer < receipts[j].Retailer
+		case "total":
+			iTotal, _ := decimal.NewFromString(receipts[i].Total)
+			jTotal, _ := decimal.NewFromString(receipts[j].Total)
+			return iTotal.LessThan(jTotal)
+		default:
+			return false
+		}
+	}
+
+	if orderDir == "desc" {
+		sort.SliceStable(receipts, func(i, j int) bool { return less(j, i) })
+		return
+	}
+	sort.SliceStable(receipts, less)
+}
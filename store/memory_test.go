@@ -0,0 +1,99 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMemoryStoreSaveAndGet(t *testing.T) {
+	s := NewMemoryStore()
+
+	receipt := Receipt{ID: "abc-123", Retailer: "Target", Total: "9.30"}
+	if _, err := s.Save(receipt); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := s.Get("abc-123")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Retailer != "Target" {
+		t.Errorf("Get().Retailer = %q, want %q", got.Retailer, "Target")
+	}
+}
+
+func TestMemoryStoreGetNotFound(t *testing.T) {
+	s := NewMemoryStore()
+
+	if _, err := s.Get("missing"); err != ErrNotFound {
+		t.Errorf("Get() error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestMemoryStoreListFilterAndPage(t *testing.T) {
+	s := NewMemoryStore()
+	s.Save(Receipt{ID: "1", Retailer: "Target"})
+	s.Save(Receipt{ID: "2", Retailer: "Walmart"})
+	s.Save(Receipt{ID: "3", Retailer: "Target Express"})
+
+	results, total, err := s.List(Filter{Retailer: "target"}, Paging{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 2 || len(results) != 2 {
+		t.Errorf("List() = %d results (total %d), want 2 (total 2)", len(results), total)
+	}
+
+	results, total, err = s.List(Filter{}, Paging{Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 3 || len(results) != 2 {
+		t.Errorf("List() = %d results (total %d), want 2 (total 3)", len(results), total)
+	}
+}
+
+func TestMemoryStoreListDateAndTotalFilter(t *testing.T) {
+	s := NewMemoryStore()
+	s.Save(Receipt{ID: "1", PurchaseDate: "2022-01-01", Total: "5.00"})
+	s.Save(Receipt{ID: "2", PurchaseDate: "2022-06-15", Total: "15.00"})
+	s.Save(Receipt{ID: "3", PurchaseDate: "2022-12-31", Total: "25.00"})
+
+	results, total, err := s.List(Filter{PurchaseDateFrom: "2022-02-01", PurchaseDateTo: "2022-12-01"}, Paging{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 1 || results[0].ID != "2" {
+		t.Errorf("List() date range = %+v (total %d), want only receipt 2", results, total)
+	}
+
+	minTotal := decimal.NewFromFloat(10)
+	results, total, err = s.List(Filter{MinTotal: &minTotal}, Paging{})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 2 {
+		t.Errorf("List() minTotal filter returned %d results, want 2", total)
+	}
+}
+
+func TestMemoryStoreListOrdering(t *testing.T) {
+	s := NewMemoryStore()
+	s.Save(Receipt{ID: "1", Retailer: "Walmart", Total: "5.00"})
+	s.Save(Receipt{ID: "2", Retailer: "Target", Total: "25.00"})
+	s.Save(Receipt{ID: "3", Retailer: "Costco", Total: "15.00"})
+
+	results, _, err := s.List(Filter{}, Paging{OrderBy: "total", OrderDir: "asc"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	gotOrder := []string{results[0].ID, results[1].ID, results[2].ID}
+	wantOrder := []string{"1", "3", "2"}
+	for i := range wantOrder {
+		if gotOrder[i] != wantOrder[i] {
+			t.Errorf("List() order = %v, want %v", gotOrder, wantOrder)
+			break
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoReceipt is the BSON document stored in the "receipts" collection.
+// Items are embedded rather than kept in their own collection since a
+// receipt's items are never queried independently of their parent.
+type mongoReceipt struct {
+	ID           string      `bson:"_id"`
+	Retailer     string      `bson:"retailer"`
+	PurchaseDate string      `bson:"purchaseDate"`
+	PurchaseTime string      `bson:"purchaseTime"`
+	Total        string      `bson:"total"`
+	Items        []mongoItem `bson:"items"`
+}
+
+type mongoItem struct {
+	ShortDescription string `bson:"shortDescription"`
+	Price            string `bson:"price"`
+}
+
+// MongoStore implements ReceiptStore on top of a MongoDB collection.
+type MongoStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoStore connects to uri and returns a MongoStore backed by
+// database dbName's "receipts" collection.
+func NewMongoStore(uri, dbName string) (*MongoStore, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, fmt.Errorf("connect mongo: %w", err)
+	}
+
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, fmt.Errorf("ping mongo: %w", err)
+	}
+
+	return &MongoStore{collection: client.Database(dbName).Collection("receipts")}, nil
+}
+
+func toMongoReceipt(r Receipt) mongoReceipt {
+	items := make([]mongoItem, len(r.Items))
+	for i, item := range r.Items {
+		items[i] = mongoItem{ShortDescription: item.ShortDescription, Price: item.Price}
+	}
+	return mongoReceipt{ID: r.ID, Retailer: r.Retailer, PurchaseDate: r.PurchaseDate, PurchaseTime: r.PurchaseTime, Total: r.Total, Items: items}
+}
+
+func fromMongoReceipt(m mongoReceipt) Receipt {
+	items := make([]Item, len(m.Items))
+	for i, item := range m.Items {
+		items[i] = Item{ShortDescription: item.ShortDescription, Price: item.Price}
+	}
+	return Receipt{ID: m.ID, Retailer: m.Retailer, PurchaseDate: m.PurchaseDate, PurchaseTime: m.PurchaseTime, Total: m.Total, Items: items}
+}
+
+// Save upserts receipt by its ID.
+func (s *MongoStore) Save(receipt Receipt) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	doc := toMongoReceipt(receipt)
+	_, err := s.collection.ReplaceOne(ctx, bson.M{"_id": doc.ID}, doc, options.Replace().SetUpsert(true))
+	if err != nil {
+		return "", fmt.Errorf("save receipt: %w", err)
+	}
+	return doc.ID, nil
+}
+
+// Get looks up a receipt by ID.
+func (s *MongoStore) Get(id string) (Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var doc mongoReceipt
+	if err := s.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return Receipt{}, ErrNotFound
+		}
+		return Receipt{}, fmt.Errorf("get receipt: %w", err)
+	}
+	return fromMongoReceipt(doc), nil
+}
+
+// List filters and pages over the receipts collection. Total is stored as a
+// string, so min/max total and ordering by total compare $toDouble of it
+// rather than the field directly.
+func (s *MongoStore) List(filter Filter, paging Paging) ([]Receipt, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	match := bson.M{}
+	if filter.Retailer != "" {
+		match["retailer"] = bson.M{"$regex": regexp.QuoteMeta(strings.ToLower(filter.Retailer)), "$options": "i"}
+	}
+
+	dateRange := bson.M{}
+	if filter.PurchaseDateFrom != "" {
+		dateRange["$gte"] = filter.PurchaseDateFrom
+	}
+	if filter.PurchaseDateTo != "" {
+		dateRange["$lte"] = filter.PurchaseDateTo
+	}
+	if len(dateRange) > 0 {
+		match["purchaseDate"] = dateRange
+	}
+
+	var totalConds bson.A
+	if filter.MinTotal != nil {
+		totalConds = append(totalConds, bson.M{"$gte": bson.A{bson.M{"$toDouble": "$total"}, filter.MinTotal.InexactFloat64()}})
+	}
+	if filter.MaxTotal != nil {
+		totalConds = append(totalConds, bson.M{"$lte": bson.A{bson.M{"$toDouble": "$total"}, filter.MaxTotal.InexactFloat64()}})
+	}
+	if len(totalConds) > 0 {
+		match["$expr"] = bson.M{"$and": totalConds}
+	}
+
+	total, err := s.collection.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count receipts: %w", err)
+	}
+
+	sortDir := 1
+	if paging.OrderDir == "desc" {
+		sortDir = -1
+	}
+
+	var docs []mongoReceipt
+	if paging.OrderBy == "total" {
+		docs, err = s.listSortedByTotal(ctx, match, sortDir, paging)
+	} else {
+		docs, err = s.listSortedByField(ctx, match, paging.OrderBy, sortDir, paging)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	receipts := make([]Receipt, len(docs))
+	for i, doc := range docs {
+		receipts[i] = fromMongoReceipt(doc)
+	}
+	return receipts, int(total), nil
+}
+
+// listSortedByField runs match through a plain Find, optionally sorted by one
+// of the receipt's own bson fields (anything but "total", which needs listSortedByTotal).
+func (s *MongoStore) listSortedByField(ctx context.Context, match bson.M, orderBy string, sortDir int, paging Paging) ([]mongoReceipt, error) {
+	findOpts := options.Find()
+	if orderBy != "" && OrderableFields[orderBy] {
+		findOpts.SetSort(bson.D{{Key: orderBy, Value: sortDir}})
+	}
+	if paging.PageSize > 0 {
+		findOpts.SetLimit(int64(paging.PageSize)).SetSkip(int64((paging.Page - 1) * paging.PageSize))
+	}
+
+	cursor, err := s.collection.Find(ctx, match, findOpts)
+	if err != nil {
+		return nil, fmt.Errorf("list receipts: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []mongoReceipt
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("decode receipts: %w", err)
+	}
+	return docs, nil
+}
+
+// listSortedByTotal aggregates match with total converted to a number so
+// ordering by total sorts numerically rather than lexicographically.
+func (s *MongoStore) listSortedByTotal(ctx context.Context, match bson.M, sortDir int, paging Paging) ([]mongoReceipt, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: match}},
+		bson.D{{Key: "$addFields", Value: bson.M{"totalNumeric": bson.M{"$toDouble": "$total"}}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "totalNumeric", Value: sortDir}}}},
+	}
+	if paging.PageSize > 0 {
+		pipeline = append(pipeline,
+			bson.D{{Key: "$skip", Value: int64((paging.Page - 1) * paging.PageSize)}},
+			bson.D{{Key: "$limit", Value: int64(paging.PageSize)}},
+		)
+	}
+
+	cursor, err := s.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("list receipts: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []mongoReceipt
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("decode receipts: %w", err)
+	}
+	return docs, nil
+}
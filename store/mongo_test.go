@@ -0,0 +1,68 @@
+//go:build integration
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/testcontainers/testcontainers-go/modules/mongodb"
+)
+
+// newTestMongoStore starts a throwaway MongoDB container via testcontainers,
+// opens a MongoStore against it, and tears the container down when the test
+// finishes.
+func newTestMongoStore(t *testing.T) *MongoStore {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := mongodb.Run(ctx, "mongo:7")
+	if err != nil {
+		t.Fatalf("start mongo container: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Logf("terminate mongo container: %v", err)
+		}
+	})
+
+	uri, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatalf("mongo connection string: %v", err)
+	}
+
+	s, err := NewMongoStore(uri, "receipts_test")
+	if err != nil {
+		t.Fatalf("NewMongoStore() error = %v", err)
+	}
+	return s
+}
+
+func TestMongoStoreSaveGetList(t *testing.T) {
+	s := newTestMongoStore(t)
+
+	s.Save(Receipt{ID: "1", Retailer: "Target", Total: "5.00"})
+	s.Save(Receipt{ID: "2", Retailer: "Walmart", Total: "100.00"})
+	s.Save(Receipt{ID: "3", Retailer: "Costco", Total: "15.00"})
+
+	got, err := s.Get("1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Retailer != "Target" {
+		t.Errorf("Get().Retailer = %q, want %q", got.Retailer, "Target")
+	}
+
+	minTotal := decimal.NewFromFloat(10)
+	results, total, err := s.List(Filter{MinTotal: &minTotal}, Paging{OrderBy: "total", OrderDir: "asc"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 2 {
+		t.Fatalf("List() total = %d, want 2", total)
+	}
+	if results[0].ID != "3" || results[1].ID != "2" {
+		t.Errorf("List() order = %v, want [3 2] (numeric total order)", []string{results[0].ID, results[1].ID})
+	}
+}
@@ -0,0 +1,24 @@
+package store
+
+import (
+	"fmt"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// NewPostgresStore opens a Postgres connection using dsn (e.g.
+// "host=localhost user=postgres password=postgres dbname=receipts
+// port=5432 sslmode=disable") and runs the receipts/items migration.
+func NewPostgresStore(dsn string) (*gormStore, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("open postgres: %w", err)
+	}
+
+	if err := migrate(db); err != nil {
+		return nil, fmt.Errorf("migrate postgres: %w", err)
+	}
+
+	return &gormStore{db: db}, nil
+}
@@ -0,0 +1,72 @@
+//go:build integration
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+)
+
+// newTestPostgresStore starts a throwaway Postgres container via
+// testcontainers, opens a gormStore against it, and tears the container
+// down when the test finishes.
+func newTestPostgresStore(t *testing.T) *gormStore {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := postgres.Run(ctx, "postgres:16-alpine",
+		postgres.WithDatabase("receipts"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+	)
+	if err != nil {
+		t.Fatalf("start postgres container: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := container.Terminate(ctx); err != nil {
+			t.Logf("terminate postgres container: %v", err)
+		}
+	})
+
+	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		t.Fatalf("postgres connection string: %v", err)
+	}
+
+	s, err := NewPostgresStore(dsn)
+	if err != nil {
+		t.Fatalf("NewPostgresStore() error = %v", err)
+	}
+	return s
+}
+
+func TestPostgresStoreSaveGetList(t *testing.T) {
+	s := newTestPostgresStore(t)
+
+	s.Save(Receipt{ID: "1", Retailer: "Target", Total: "5.00"})
+	s.Save(Receipt{ID: "2", Retailer: "Walmart", Total: "100.00"})
+	s.Save(Receipt{ID: "3", Retailer: "Costco", Total: "15.00"})
+
+	got, err := s.Get("1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Retailer != "Target" {
+		t.Errorf("Get().Retailer = %q, want %q", got.Retailer, "Target")
+	}
+
+	minTotal := decimal.NewFromFloat(10)
+	results, total, err := s.List(Filter{MinTotal: &minTotal}, Paging{OrderBy: "total", OrderDir: "asc"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 2 {
+		t.Fatalf("List() total = %d, want 2", total)
+	}
+	if results[0].ID != "3" || results[1].ID != "2" {
+		t.Errorf("List() order = %v, want [3 2] (numeric total order)", []string{results[0].ID, results[1].ID})
+	}
+}
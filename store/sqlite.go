@@ -0,0 +1,23 @@
+package store
+
+import (
+	"fmt"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// NewSQLiteStore opens the SQLite database at path (use ":memory:" for an
+// ephemeral DB, handy in tests) and runs the receipts/items migration.
+func NewSQLiteStore(path string) (*gormStore, error) {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite: %w", err)
+	}
+
+	if err := migrate(db); err != nil {
+		return nil, fmt.Errorf("migrate sqlite: %w", err)
+	}
+
+	return &gormStore{db: db}, nil
+}
@@ -0,0 +1,74 @@
+// Package store defines the persistence layer for receipts, decoupling
+// processReceipts/getPoints from any particular database.
+package store
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrNotFound is returned by Get when no receipt exists for the given id.
+var ErrNotFound = errors.New("receipt not found")
+
+// OrderableFields lists the receipt fields List's Paging.OrderBy may sort by.
+// It exists so SQL-backed stores can validate OrderBy before interpolating it
+// into an ORDER BY clause.
+var OrderableFields = map[string]bool{
+	"purchaseDate": true,
+	"purchaseTime": true,
+	"retailer":     true,
+	"total":        true,
+}
+
+// Receipt is the persisted form of the JSON receipt payload, keyed by ID.
+type Receipt struct {
+	ID           string `json:"id"`
+	Retailer     string `json:"retailer"`
+	PurchaseDate string `json:"purchaseDate"`
+	PurchaseTime string `json:"purchaseTime"`
+	Items        []Item `json:"items"`
+	Total        string `json:"total"`
+}
+
+// Item is a single line item on a receipt.
+type Item struct {
+	ShortDescription string `json:"shortDescription"`
+	Price            string `json:"price"`
+}
+
+// Filter narrows a List call down to a subset of receipts. Zero values mean
+// "no constraint" for every field.
+type Filter struct {
+	Retailer         string // substring match, case-insensitive
+	PurchaseDateFrom string // yyyy-mm-dd, inclusive
+	PurchaseDateTo   string // yyyy-mm-dd, inclusive
+	MinTotal         *decimal.Decimal
+	MaxTotal         *decimal.Decimal
+}
+
+// Paging controls which page of a List call is returned, and how it's ordered.
+type Paging struct {
+	Page     int // 1-indexed
+	PageSize int
+
+	// OrderBy must be a key of OrderableFields, or empty for the store's default order.
+	OrderBy string
+	// OrderDir is "asc" or "desc"; empty means "asc".
+	OrderDir string
+}
+
+// ReceiptStore is implemented by every storage backend (memory, Postgres,
+// SQLite, Mongo). Handlers depend on this interface rather than on a
+// concrete database so the backend can be swapped via config.
+type ReceiptStore interface {
+	// Save persists receipt and returns the ID it was stored under.
+	Save(receipt Receipt) (string, error)
+
+	// Get looks up a receipt by ID, returning ErrNotFound if it doesn't exist.
+	Get(id string) (Receipt, error)
+
+	// List returns receipts matching filter, paged according to paging,
+	// along with the total count of matching receipts (ignoring paging).
+	List(filter Filter, paging Paging) ([]Receipt, int, error)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// retailerPattern is the set of characters a valid retailer name may contain.
+var retailerPattern = regexp.MustCompile(`^[A-Za-z0-9\s]+$`)
+
+// ValidationError reports which field of a Receipt failed validation and why,
+// so callers (the single and bulk endpoints) can surface a field-level error.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidateReceipt checks receipt against the rules processReceipts has always applied
+// (a non-empty alphanumeric retailer, a valid purchase date and time, and a non-empty
+// description plus parseable price for every item), plus a parseable total.
+func ValidateReceipt(receipt Receipt) error {
+	if !retailerPattern.MatchString(receipt.Retailer) {
+		return &ValidationError{Field: "retailer", Message: "Invalid retailer name"}
+	}
+
+	if _, err := time.Parse("2006-01-02", receipt.PurchaseDate); err != nil {
+		return &ValidationError{Field: "purchaseDate", Message: "Invalid purchase date"}
+	}
+
+	if _, err := time.Parse("15:04", receipt.PurchaseTime); err != nil {
+		return &ValidationError{Field: "purchaseTime", Message: "Invalid purchase time"}
+	}
+
+	if _, err := decimal.NewFromString(receipt.Total); err != nil {
+		return &ValidationError{Field: "total", Message: "Invalid total"}
+	}
+
+	for i, item := range receipt.Items {
+		if item.ShortDescription == "" {
+			return &ValidationError{Field: fmt.Sprintf("items[%d].shortDescription", i), Message: "Item description cannot be empty"}
+		}
+		if _, err := decimal.NewFromString(item.Price); err != nil {
+			return &ValidationError{Field: fmt.Sprintf("items[%d].price", i), Message: "Invalid item price"}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import "testing"
+
+func TestValidateReceiptValid(t *testing.T) {
+	receipt := Receipt{
+		Retailer:     "Target",
+		PurchaseDate: "2022-01-01",
+		PurchaseTime: "13:01",
+		Total:        "6.49",
+		Items: []Item{
+			{ShortDescription: "Pepsi", Price: "6.49"},
+		},
+	}
+
+	if err := ValidateReceipt(receipt); err != nil {
+		t.Errorf("ValidateReceipt() error = %v, want nil", err)
+	}
+}
+
+func TestValidateReceiptInvalidFields(t *testing.T) {
+	base := Receipt{
+		Retailer:     "Target",
+		PurchaseDate: "2022-01-01",
+		PurchaseTime: "13:01",
+		Total:        "6.49",
+		Items:        []Item{{ShortDescription: "Pepsi", Price: "6.49"}},
+	}
+
+	tests := []struct {
+		name      string
+		mutate    func(r Receipt) Receipt
+		wantField string
+	}{
+		{name: "bad retailer", mutate: func(r Receipt) Receipt { r.Retailer = "Target!!"; return r }, wantField: "retailer"},
+		{name: "bad purchase date", mutate: func(r Receipt) Receipt { r.PurchaseDate = "01-01-2022"; return r }, wantField: "purchaseDate"},
+		{name: "bad purchase time", mutate: func(r Receipt) Receipt { r.PurchaseTime = "1:01pm"; return r }, wantField: "purchaseTime"},
+		{name: "bad total", mutate: func(r Receipt) Receipt { r.Total = "six dollars"; return r }, wantField: "total"},
+		{name: "empty item description", mutate: func(r Receipt) Receipt { r.Items[0].ShortDescription = ""; return r }, wantField: "items[0].shortDescription"},
+		{name: "bad item price", mutate: func(r Receipt) Receipt { r.Items[0].Price = "free"; return r }, wantField: "items[0].price"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receipt := base
+			receipt.Items = append([]Item(nil), base.Items...)
+			receipt = tt.mutate(receipt)
+			err := ValidateReceipt(receipt)
+			if err == nil {
+				t.Fatal("ValidateReceipt() error = nil, want error")
+			}
+			verr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("ValidateReceipt() error type = %T, want *ValidationError", err)
+			}
+			if verr.Field != tt.wantField {
+				t.Errorf("ValidateReceipt() Field = %q, want %q", verr.Field, tt.wantField)
+			}
+		})
+	}
+}
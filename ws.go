@@ -0,0 +1,47 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// upgrader upgrades the HTTP connection to a WebSocket for streaming receipt
+// lifecycle events. CheckOrigin allows all origins since this is a read-only
+// event stream with no cookies or credentials involved.
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// streamReceiptEvents upgrades the connection to a WebSocket and relays every
+// receipt_processed/points_calculated event published on the event bus as a
+// JSON text message, until the client disconnects.
+func (a *api) streamReceiptEvents(c *gin.Context) {
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	ctx := c.Request.Context()
+	stream, unsubscribe, err := a.events.Subscribe(ctx)
+	if err != nil {
+		return
+	}
+	defer unsubscribe()
+
+	for {
+		select {
+		case event, ok := <-stream:
+			if !ok {
+				return
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}